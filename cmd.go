@@ -40,6 +40,7 @@ func parseCmd() *Cmd {
 // printUsage print bingo usage
 func printUsage() {
 	fmt.Println("Usage: vgo --ip <ip> --port <port> --config <config file path>")
+	fmt.Println("       vgo --config <config file path> warm <go.mod|go.sum|list file|path@version>...")
 }
 
 // printVersion print bingo version
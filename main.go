@@ -33,8 +33,6 @@ import (
 	_ "net/http/pprof"
 	"log"
 	"net/http"
-	"io/ioutil"
-	"encoding/json"
 )
 
 func main() {
@@ -54,21 +52,22 @@ func main() {
 		return
 	}
 
-	data, err := ioutil.ReadFile(cmd.Config)
+	cfg, err := Main.LoadConfig(cmd.Config)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		return
 	}
 
-	cfg := &Main.Config{}
-
-	err = json.Unmarshal(data, cfg)
+	err = cfg.Init()
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		return
 	}
 
-	cfg.Init()
+	if len(cmd.Args) > 0 && cmd.Args[0] == "warm" {
+		warm(cfg, cmd.Args[1:])
+		return
+	}
 
 	go func() {
 		log.Println(http.ListenAndServe("localhost:6060", nil))
@@ -76,3 +75,16 @@ func main() {
 
 	Main.Serve(cmd.IP, cmd.Port, cfg)
 }
+
+// warm runs the warm subcommand, which fetches the modules named by args
+// into the cache of the proxy, and exits with status 1 if any failed.
+func warm(cfg *Main.Config, args []string) {
+	report, err := Main.Warm(cfg, args, os.Stderr)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "vgo warm: %v\n", err)
+		os.Exit(2)
+	}
+	if len(report.Failures) > 0 {
+		os.Exit(1)
+	}
+}